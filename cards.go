@@ -1,7 +1,17 @@
 package cards
 
-// Suit
-type Suit byte
+import (
+	"encoding"
+	"encoding/json"
+)
+
+// Suit identifies the suit of a card. The zero value is reserved for cards
+// that have no suit, such as jokers.
+//
+// Suit values beyond the predefined constants may be used to support variant
+// decks with non-standard suits; register a display name and short-form
+// rune for such values with RegisterSuit.
+type Suit int
 
 const (
 	Spades Suit = iota + 1
@@ -11,35 +21,58 @@ const (
 )
 
 func (s Suit) String() string {
-	switch s {
-	case Spades:
-		return "Spades"
-	case Hearts:
-		return "Hearts"
-	case Diamonds:
-		return "Diamonds"
-	case Clubs:
-		return "Clubs"
+	if e, ok := suitRegistry[s]; ok {
+		return e.name
 	}
 	return "Nulls"
 }
 
 func (s Suit) Short() rune {
-	switch s {
-	case Clubs:
-		return 'C' // '♣'
-	case Diamonds:
-		return 'D' // '♦'
-	case Hearts:
-		return 'H' // '♥'
-	case Spades:
-		return 'S' // '♠'
+	if e, ok := suitRegistry[s]; ok {
+		return e.short
 	}
 	return '?'
 }
 
-// Rank
-type Rank byte
+type suitEntry struct {
+	name  string
+	short rune
+}
+
+var suitRegistry = map[Suit]suitEntry{
+	Spades:   {"Spades", 'S'},   // '♠'
+	Hearts:   {"Hearts", 'H'},   // '♥'
+	Diamonds: {"Diamonds", 'D'}, // '♦'
+	Clubs:    {"Clubs", 'C'},    // '♣'
+}
+
+// suitByShort is the reverse of suitRegistry, keyed by short-form rune, so
+// that ParseCard and friends can recover a registered Suit (including
+// built-in ones) from its Short rune.
+var suitByShort = map[rune]Suit{}
+
+func init() {
+	for s, e := range suitRegistry {
+		suitByShort[e.short] = s
+	}
+}
+
+// RegisterSuit associates a display name and a short-form rune with s, so
+// that a custom Suit value used by a variant deck (see NewCustomDeck) is
+// recognized by String, Short, and ParseCard/ParseGroup. Registering an
+// existing Suit value overwrites its entry.
+func RegisterSuit(s Suit, name string, short rune) {
+	suitRegistry[s] = suitEntry{name, short}
+	suitByShort[short] = s
+}
+
+// Rank identifies the rank of a card. The zero value is reserved for cards
+// that have no rank, such as jokers.
+//
+// Rank values beyond the predefined constants may be used to support variant
+// decks with non-standard ranks; register a display name and short-form
+// rune for such values with RegisterRank.
+type Rank int
 
 const (
 	Ace Rank = iota + 1
@@ -58,69 +91,60 @@ const (
 )
 
 func (r Rank) String() string {
-	switch r {
-	case Ace:
-		return "Ace"
-	case Two:
-		return "Two"
-	case Three:
-		return "Three"
-	case Four:
-		return "Four"
-	case Five:
-		return "Five"
-	case Six:
-		return "Six"
-	case Seven:
-		return "Seven"
-	case Eight:
-		return "Eight"
-	case Nine:
-		return "Nine"
-	case Ten:
-		return "Ten"
-	case Jack:
-		return "Jack"
-	case Queen:
-		return "Queen"
-	case King:
-		return "King"
+	if e, ok := rankRegistry[r]; ok {
+		return e.name
 	}
 	return "Null"
 }
 
 func (r Rank) Short() rune {
-	switch r {
-	case Ace:
-		return 'A'
-	case Two:
-		return '2'
-	case Three:
-		return '3'
-	case Four:
-		return '4'
-	case Five:
-		return '5'
-	case Six:
-		return '6'
-	case Seven:
-		return '7'
-	case Eight:
-		return '8'
-	case Nine:
-		return '9'
-	case Ten:
-		return 'T'
-	case Jack:
-		return 'J'
-	case Queen:
-		return 'Q'
-	case King:
-		return 'K'
+	if e, ok := rankRegistry[r]; ok {
+		return e.short
 	}
 	return '?'
 }
 
+type rankEntry struct {
+	name  string
+	short rune
+}
+
+var rankRegistry = map[Rank]rankEntry{
+	Ace:   {"Ace", 'A'},
+	Two:   {"Two", '2'},
+	Three: {"Three", '3'},
+	Four:  {"Four", '4'},
+	Five:  {"Five", '5'},
+	Six:   {"Six", '6'},
+	Seven: {"Seven", '7'},
+	Eight: {"Eight", '8'},
+	Nine:  {"Nine", '9'},
+	Ten:   {"Ten", 'T'},
+	Jack:  {"Jack", 'J'},
+	Queen: {"Queen", 'Q'},
+	King:  {"King", 'K'},
+}
+
+// rankByShort is the reverse of rankRegistry, keyed by short-form rune, so
+// that ParseCard and friends can recover a registered Rank (including
+// built-in ones) from its Short rune.
+var rankByShort = map[rune]Rank{}
+
+func init() {
+	for r, e := range rankRegistry {
+		rankByShort[e.short] = r
+	}
+}
+
+// RegisterRank associates a display name and a short-form rune with r, so
+// that a custom Rank value used by a variant deck (see NewCustomDeck) is
+// recognized by String, Short, and ParseCard/ParseGroup. Registering an
+// existing Rank value overwrites its entry.
+func RegisterRank(r Rank, name string, short rune) {
+	rankRegistry[r] = rankEntry{name, short}
+	rankByShort[short] = r
+}
+
 // Card
 type Card interface {
 	Suit() Suit
@@ -128,6 +152,18 @@ type Card interface {
 	Joker() bool
 	String() string
 	Short() string
+
+	// Text marshaling follows Short's form, binary marshaling packs the card
+	// into a single byte (see MarshalBinary), and JSON marshaling emits a
+	// {"rank":"A","suit":"S","faceup":true} object ("joker":true
+	// in place of rank/suit for a joker). Since a lone Card has no
+	// direction, it always marshals as face-up; use UnmarshalCardBinary
+	// or UnmarshalCardJSON (or ParseCard, for text) to unmarshal one back,
+	// since Card is an interface with no addressable concrete type to
+	// unmarshal into.
+	encoding.BinaryMarshaler
+	encoding.TextMarshaler
+	json.Marshaler
 }
 
 type card struct {
@@ -0,0 +1,157 @@
+package cards
+
+import "errors"
+
+// ErrNotEnoughCards is returned by a Dealer's dealing methods when the
+// requested number of cards exceeds what remains in its deck.
+var ErrNotEnoughCards = errors.New("cards: not enough cards remain in the deck")
+
+// Dealer models a multi-player deal from a deck: it tracks each player's
+// hand, a burn pile, a shared community pile, and which player currently
+// holds the dealer button. It turns the low-level Draw/Insert primitives of
+// Group into game-runtime bookkeeping usable by poker, blackjack, rummy, and
+// similar games.
+type Dealer struct {
+	deck      Group
+	hands     []Group
+	burn      Group
+	community Group
+	button    int
+}
+
+// NewDealer returns a Dealer that deals from deck to the given number of
+// players. The dealer button starts at player 0.
+func NewDealer(deck Group, players int) *Dealer {
+	if players < 0 {
+		players = 0
+	}
+	hands := make([]Group, players)
+	for i := range hands {
+		hands[i] = NewGroup()
+	}
+	return &Dealer{
+		deck:      deck,
+		hands:     hands,
+		burn:      NewGroup(),
+		community: NewGroup(),
+	}
+}
+
+// Players returns the number of players the Dealer deals to.
+func (d *Dealer) Players() int {
+	return len(d.hands)
+}
+
+// Button returns the index of the player currently holding the dealer
+// button.
+func (d *Dealer) Button() int {
+	return d.button
+}
+
+// Rotate advances the dealer button to the next player.
+func (d *Dealer) Rotate() {
+	if len(d.hands) == 0 {
+		return
+	}
+	d.button = (d.button + 1) % len(d.hands)
+}
+
+// Remaining returns the number of cards left in the deck.
+func (d *Dealer) Remaining() int {
+	return d.deck.Len()
+}
+
+// Hands returns each player's hand, in player order.
+func (d *Dealer) Hands() []Group {
+	return append([]Group{}, d.hands...)
+}
+
+// BurnPile returns the cards removed from play by Burn.
+func (d *Dealer) BurnPile() Group {
+	return d.burn
+}
+
+// CommunityPile returns the shared cards dealt so far by Community.
+func (d *Dealer) CommunityPile() Group {
+	return d.community
+}
+
+// Deal deals cardsPerPlayer cards to each player, one card at a time in
+// round-robin order starting with the player to the left of the dealer
+// button, drawing from the top of the deck. It returns the updated hand of
+// each player, in player order. If the deck does not hold enough cards to
+// complete the deal, it returns ErrNotEnoughCards and leaves the deck and
+// hands untouched.
+func (d *Dealer) Deal(cardsPerPlayer int) ([]Group, error) {
+	if err := d.deal(cardsPerPlayer, false); err != nil {
+		return nil, err
+	}
+	return d.Hands(), nil
+}
+
+// DealBlock is like Deal, but deals each player's cards as a single block
+// instead of one at a time in round-robin order.
+func (d *Dealer) DealBlock(cardsPerPlayer int) ([]Group, error) {
+	if err := d.deal(cardsPerPlayer, true); err != nil {
+		return nil, err
+	}
+	return d.Hands(), nil
+}
+
+func (d *Dealer) deal(cardsPerPlayer int, block bool) error {
+	if cardsPerPlayer < 0 {
+		cardsPerPlayer = 0
+	}
+	n := len(d.hands)
+	if n == 0 || cardsPerPlayer == 0 {
+		return nil
+	}
+	if cardsPerPlayer*n > d.deck.Len() {
+		return ErrNotEnoughCards
+	}
+
+	start := (d.button + 1) % n
+	if block {
+		for i := 0; i < n; i++ {
+			p := (start + i) % n
+			d.hands[p].Insert(d.deck.Draw(cardsPerPlayer))
+		}
+		return nil
+	}
+	for round := 0; round < cardsPerPlayer; round++ {
+		for i := 0; i < n; i++ {
+			p := (start + i) % n
+			d.hands[p].Insert(d.deck.Draw(1))
+		}
+	}
+	return nil
+}
+
+// Burn removes n cards from the top of the deck, setting them aside in the
+// burn pile. If the deck does not hold n cards, it returns
+// ErrNotEnoughCards and leaves the deck untouched.
+func (d *Dealer) Burn(n int) error {
+	if n < 0 {
+		n = 0
+	}
+	if n > d.deck.Len() {
+		return ErrNotEnoughCards
+	}
+	d.burn.Insert(d.deck.Draw(n))
+	return nil
+}
+
+// Community deals n cards from the top of the deck into the shared
+// community pile, as used for the flop, turn, and river in poker, and
+// returns the community pile as dealt so far. If the deck does not hold n
+// cards, it returns ErrNotEnoughCards and leaves the deck untouched.
+func (d *Dealer) Community(n int) (Group, error) {
+	if n < 0 {
+		n = 0
+	}
+	if n > d.deck.Len() {
+		return nil, ErrNotEnoughCards
+	}
+	d.community.Insert(d.deck.Draw(n))
+	return d.community, nil
+}
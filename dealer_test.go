@@ -0,0 +1,92 @@
+package cards
+
+import "testing"
+
+func TestDealerDealRoundRobin(t *testing.T) {
+	d := NewDealer(NewStandardDeck(), 4)
+	hands, err := d.Deal(2)
+	if err != nil {
+		t.Fatalf("Deal: unexpected error: %v", err)
+	}
+	if len(hands) != 4 {
+		t.Fatalf("Deal: len(hands) = %d, want 4", len(hands))
+	}
+	for i, h := range hands {
+		if h.Len() != 2 {
+			t.Errorf("Deal: hand %d has %d cards, want 2", i, h.Len())
+		}
+	}
+	if d.Remaining() != 52-8 {
+		t.Errorf("Deal: Remaining() = %d, want %d", d.Remaining(), 52-8)
+	}
+}
+
+func TestDealerDealBlock(t *testing.T) {
+	d := NewDealer(NewStandardDeck(), 3)
+	hands, err := d.DealBlock(5)
+	if err != nil {
+		t.Fatalf("DealBlock: unexpected error: %v", err)
+	}
+	for i, h := range hands {
+		if h.Len() != 5 {
+			t.Errorf("DealBlock: hand %d has %d cards, want 5", i, h.Len())
+		}
+	}
+}
+
+func TestDealerDealNotEnoughCards(t *testing.T) {
+	d := NewDealer(NewStandardDeck(), 4)
+	if _, err := d.Deal(20); err != ErrNotEnoughCards {
+		t.Errorf("Deal: err = %v, want %v", err, ErrNotEnoughCards)
+	}
+	if d.Remaining() != 52 {
+		t.Errorf("Deal: deck was consumed despite returning an error, Remaining() = %d", d.Remaining())
+	}
+}
+
+func TestDealerBurnAndCommunity(t *testing.T) {
+	d := NewDealer(NewStandardDeck(), 2)
+	if _, err := d.Deal(2); err != nil {
+		t.Fatalf("Deal: unexpected error: %v", err)
+	}
+	if err := d.Burn(1); err != nil {
+		t.Fatalf("Burn: unexpected error: %v", err)
+	}
+	flop, err := d.Community(3)
+	if err != nil {
+		t.Fatalf("Community: unexpected error: %v", err)
+	}
+	if flop.Len() != 3 {
+		t.Fatalf("Community(3): Len() = %d, want 3", flop.Len())
+	}
+	if err := d.Burn(1); err != nil {
+		t.Fatalf("Burn: unexpected error: %v", err)
+	}
+	turn, err := d.Community(1)
+	if err != nil {
+		t.Fatalf("Community: unexpected error: %v", err)
+	}
+	if turn.Len() != 4 {
+		t.Fatalf("Community(1) after flop: Len() = %d, want 4 (cumulative)", turn.Len())
+	}
+	wantRemaining := 52 - 2*2 - 2 - 4
+	if d.Remaining() != wantRemaining {
+		t.Errorf("Remaining() = %d, want %d", d.Remaining(), wantRemaining)
+	}
+}
+
+func TestDealerRotate(t *testing.T) {
+	d := NewDealer(NewStandardDeck(), 3)
+	if d.Button() != 0 {
+		t.Fatalf("Button() = %d, want 0", d.Button())
+	}
+	d.Rotate()
+	if d.Button() != 1 {
+		t.Errorf("Button() after Rotate() = %d, want 1", d.Button())
+	}
+	d.Rotate()
+	d.Rotate()
+	if d.Button() != 0 {
+		t.Errorf("Button() after wrapping = %d, want 0", d.Button())
+	}
+}
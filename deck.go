@@ -0,0 +1,77 @@
+package cards
+
+// NewDeckWithJokers returns a standard 52-card deck with n jokers added on
+// top.
+func NewDeckWithJokers(n int) Group {
+	deck := NewStandardDeck()
+	if n <= 0 {
+		return deck
+	}
+	jokers := make([]Card, n)
+	for i := range jokers {
+		jokers[i] = Joker()
+	}
+	deck.Insert(NewGroup(jokers...))
+	return deck
+}
+
+// NewEuchreDeck returns a 24-card euchre deck, containing only the Nine
+// through Ace of each suit.
+func NewEuchreDeck() Group {
+	return NewCustomDeck(
+		[]Rank{Nine, Ten, Jack, Queen, King, Ace},
+		[]Suit{Spades, Hearts, Diamonds, Clubs},
+		0,
+	)
+}
+
+// NewPinochleDeck returns a 48-card pinochle deck, containing two copies of
+// the Nine through Ace of each suit.
+func NewPinochleDeck() Group {
+	return NewMultiDeck(NewEuchreDeck(), 2)
+}
+
+// NewShortDeck returns a 36-card short deck, containing only the Six through
+// Ace of each suit, as used by short-deck hold'em.
+func NewShortDeck() Group {
+	return NewCustomDeck(
+		[]Rank{Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace},
+		[]Suit{Spades, Hearts, Diamonds, Clubs},
+		0,
+	)
+}
+
+// NewMultiDeck returns a deck made of the given number of copies of base's
+// cards, as used by games that deal from more than one deck at a time.
+func NewMultiDeck(base Group, copies int) Group {
+	if copies < 0 {
+		copies = 0
+	}
+	cards := base.Cards()
+	all := make([]Card, 0, len(cards)*copies)
+	for i := 0; i < copies; i++ {
+		all = append(all, cards...)
+	}
+	return NewGroup(all...)
+}
+
+// NewCustomDeck returns a deck containing one card for each combination of
+// ranks and suits, followed by the given number of jokers. Suits and ranks
+// outside of the predefined constants may be used to build variant decks;
+// register them with RegisterSuit and RegisterRank so that they have a name
+// and short-form rune.
+func NewCustomDeck(ranks []Rank, suits []Suit, jokers int) Group {
+	if jokers < 0 {
+		jokers = 0
+	}
+	cards := make([]Card, 0, len(ranks)*len(suits)+jokers)
+	for _, s := range suits {
+		for _, r := range ranks {
+			cards = append(cards, NewCard(r, s))
+		}
+	}
+	for i := 0; i < jokers; i++ {
+		cards = append(cards, Joker())
+	}
+	return NewGroup(cards...)
+}
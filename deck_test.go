@@ -0,0 +1,116 @@
+package cards
+
+import "testing"
+
+func TestNewDeckWithJokers(t *testing.T) {
+	deck := NewDeckWithJokers(2)
+	if deck.Len() != 54 {
+		t.Fatalf("NewDeckWithJokers(2): Len() = %d, want 54", deck.Len())
+	}
+	jokers := 0
+	for _, c := range deck.Cards() {
+		if c.Joker() {
+			jokers++
+		}
+	}
+	if jokers != 2 {
+		t.Errorf("NewDeckWithJokers(2): found %d jokers, want 2", jokers)
+	}
+}
+
+func TestNewEuchreDeck(t *testing.T) {
+	deck := NewEuchreDeck()
+	if deck.Len() != 24 {
+		t.Fatalf("NewEuchreDeck: Len() = %d, want 24", deck.Len())
+	}
+	// Rank is ordered Ace-low, so a Euchre deck's Nine-or-higher range must be
+	// checked against a membership set rather than a raw "<" comparison.
+	want := map[Rank]bool{Nine: true, Ten: true, Jack: true, Queen: true, King: true, Ace: true}
+	for _, c := range deck.Cards() {
+		if !want[c.Rank()] {
+			t.Errorf("NewEuchreDeck: found rank %v, want Nine, Ten, Jack, Queen, King, or Ace", c.Rank())
+		}
+	}
+}
+
+func TestNewPinochleDeck(t *testing.T) {
+	deck := NewPinochleDeck()
+	if deck.Len() != 48 {
+		t.Fatalf("NewPinochleDeck: Len() = %d, want 48", deck.Len())
+	}
+	counts := map[string]int{}
+	for _, c := range deck.Cards() {
+		counts[c.Short()]++
+	}
+	for short, n := range counts {
+		if n != 2 {
+			t.Errorf("NewPinochleDeck: %s appears %d times, want 2", short, n)
+		}
+	}
+}
+
+func TestNewShortDeck(t *testing.T) {
+	deck := NewShortDeck()
+	if deck.Len() != 36 {
+		t.Fatalf("NewShortDeck: Len() = %d, want 36", deck.Len())
+	}
+	// Rank is ordered Ace-low, so a Short deck's Six-or-higher range must be
+	// checked against a membership set rather than a raw "<" comparison.
+	want := map[Rank]bool{Six: true, Seven: true, Eight: true, Nine: true, Ten: true, Jack: true, Queen: true, King: true, Ace: true}
+	for _, c := range deck.Cards() {
+		if !want[c.Rank()] {
+			t.Errorf("NewShortDeck: found rank %v, want Six through King, or Ace", c.Rank())
+		}
+	}
+}
+
+func TestNewMultiDeck(t *testing.T) {
+	deck := NewMultiDeck(NewStandardDeck(), 3)
+	if deck.Len() != 156 {
+		t.Fatalf("NewMultiDeck(standard, 3): Len() = %d, want 156", deck.Len())
+	}
+}
+
+func TestNewCustomDeckWithRegisteredSuit(t *testing.T) {
+	const Stars Suit = 5
+	RegisterSuit(Stars, "Stars", '*')
+
+	deck := NewCustomDeck([]Rank{Ace, King}, []Suit{Spades, Stars}, 2)
+	if deck.Len() != 6 {
+		t.Fatalf("NewCustomDeck: Len() = %d, want 6", deck.Len())
+	}
+
+	var found bool
+	for _, c := range deck.Cards() {
+		if c.Suit() == Stars {
+			found = true
+			if c.String() != c.Rank().String()+" of Stars" {
+				t.Errorf("registered suit String() = %q", c.String())
+			}
+			if c.Short()[1] != '*' {
+				t.Errorf("registered suit Short() = %q, want suffix '*'", c.Short())
+			}
+
+			parsed, err := ParseCard(c.Short())
+			if err != nil {
+				t.Errorf("ParseCard(%q): unexpected error: %v", c.Short(), err)
+			} else if parsed.Suit() != Stars || parsed.Rank() != c.Rank() {
+				t.Errorf("ParseCard(%q) = %v, want rank %v suit Stars", c.Short(), parsed, c.Rank())
+			}
+
+			data, err := c.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON(%v): unexpected error: %v", c, err)
+			}
+			decoded, err := UnmarshalCardJSON(data)
+			if err != nil {
+				t.Errorf("UnmarshalCardJSON(%q): unexpected error: %v", data, err)
+			} else if decoded.Suit() != Stars || decoded.Rank() != c.Rank() {
+				t.Errorf("UnmarshalCardJSON(%q) = %v, want rank %v suit Stars", data, decoded, c.Rank())
+			}
+		}
+	}
+	if !found {
+		t.Errorf("NewCustomDeck: no card with the registered Stars suit found")
+	}
+}
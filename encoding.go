@@ -0,0 +1,324 @@
+package cards
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrNotACard is returned when attempting to unmarshal a joker into a card,
+// or vice versa.
+var ErrNotACard = errors.New("cards: value is not the expected card type")
+
+// Binary encoding packs a card into a single byte: bit 7 is the face-up
+// flag, bits 4-6 are the suit, and bits 0-3 are the rank. The bytes 0xFE and
+// 0xFF are reserved for a face-down and a face-up joker respectively; a
+// face-up card of suit 7 with rank 14 or 15 would produce them, so encoding
+// such a card fails with ErrInvalidCard.
+const (
+	jokerFaceDownByte = 0xFE
+	jokerFaceUpByte   = 0xFF
+)
+
+func encodeCardByte(c Card, faceup bool) (byte, error) {
+	if c.Joker() {
+		if faceup {
+			return jokerFaceUpByte, nil
+		}
+		return jokerFaceDownByte, nil
+	}
+	r, s := int(c.Rank()), int(c.Suit())
+	if r < 0 || r > 0xF {
+		return 0, ErrInvalidCard
+	}
+	if s < 0 || s > 0x7 {
+		return 0, ErrInvalidCard
+	}
+	b := byte(r) | byte(s)<<4
+	if faceup {
+		b |= 0x80
+	}
+	// A face-up card with suit 7 and rank 14 or 15, which only custom
+	// values passed to RegisterSuit/RegisterRank can reach, produces one of
+	// the two bytes reserved for a joker. Reject rather than silently
+	// collide.
+	if b == jokerFaceDownByte || b == jokerFaceUpByte {
+		return 0, ErrInvalidCard
+	}
+	return b, nil
+}
+
+func decodeCardByte(b byte) (c Card, faceup bool, err error) {
+	switch b {
+	case jokerFaceUpByte:
+		return Joker(), true, nil
+	case jokerFaceDownByte:
+		return Joker(), false, nil
+	}
+	faceup = b&0x80 != 0
+	s := Suit((b >> 4) & 0x7)
+	r := Rank(b & 0xF)
+	return NewCard(r, s), faceup, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Since a lone card has no
+// direction, it is always encoded as face-up.
+func (c card) MarshalBinary() ([]byte, error) {
+	b, err := encodeCardByte(c, true)
+	if err != nil {
+		return nil, err
+	}
+	return []byte{b}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *card) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return ErrInvalidCard
+	}
+	decoded, _, err := decodeCardByte(data[0])
+	if err != nil {
+		return err
+	}
+	cc, ok := decoded.(card)
+	if !ok {
+		return ErrNotACard
+	}
+	*c = cc
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Since a lone joker has
+// no direction, it is always encoded as face-up.
+func (joker) MarshalBinary() ([]byte, error) {
+	return []byte{jokerFaceUpByte}, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (j *joker) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || (data[0] != jokerFaceUpByte && data[0] != jokerFaceDownByte) {
+		return ErrNotACard
+	}
+	return nil
+}
+
+// UnmarshalCardBinary decodes a Card from its single-byte binary form, as
+// produced by Card.MarshalBinary. It exists because Card is an interface, so
+// there is no concrete type for encoding/binary helpers to unmarshal into
+// directly.
+func UnmarshalCardBinary(data []byte) (Card, error) {
+	if len(data) != 1 {
+		return nil, ErrInvalidCard
+	}
+	c, _, err := decodeCardByte(data[0])
+	return c, err
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The result is a 4-byte
+// big-endian card count, followed by one byte per card (see
+// Card.MarshalBinary), so that a full 52-card deck fits in 56 bytes.
+func (g *group) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 4+len(g.cards))
+	binary.BigEndian.PutUint32(data[:4], uint32(len(g.cards)))
+	for i, c := range g.cards {
+		b, err := encodeCardByte(c, g.flipd[i])
+		if err != nil {
+			return nil, err
+		}
+		data[4+i] = b
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (g *group) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return ErrInvalidGroup
+	}
+	n := binary.BigEndian.Uint32(data[:4])
+	if uint32(len(data)-4) != n {
+		return ErrInvalidGroup
+	}
+	cards := make([]Card, n)
+	flipd := make([]bool, n)
+	for i := uint32(0); i < n; i++ {
+		c, faceup, err := decodeCardByte(data[4+i])
+		if err != nil {
+			return err
+		}
+		cards[i] = c
+		flipd[i] = faceup
+	}
+	g.cards = cards
+	g.flipd = flipd
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same short-string
+// notation as Card.Short.
+func (c card) MarshalText() ([]byte, error) {
+	return []byte(c.Short()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *card) UnmarshalText(data []byte) error {
+	parsed, _, err := parseToken(string(data))
+	if err != nil {
+		return err
+	}
+	cc, ok := parsed.(card)
+	if !ok {
+		return ErrNotACard
+	}
+	*c = cc
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (joker) MarshalText() ([]byte, error) {
+	return []byte("JO"), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (j *joker) UnmarshalText(data []byte) error {
+	if strings.ToUpper(string(data)) != "JO" {
+		return ErrNotACard
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the same bracketed
+// short-string notation as Group.String.
+func (g *group) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (g *group) UnmarshalText(data []byte) error {
+	ng, err := ParseGroup(string(data))
+	if err != nil {
+		return err
+	}
+	parsed := ng.(*group)
+	g.cards = parsed.cards
+	g.flipd = parsed.flipd
+	return nil
+}
+
+// cardJSON is the wire format used to marshal and unmarshal a Card:
+// {"rank":"A","suit":"S","faceup":true}, or {"joker":true,"faceup":true} for
+// a joker.
+type cardJSON struct {
+	Rank   string `json:"rank,omitempty"`
+	Suit   string `json:"suit,omitempty"`
+	Joker  bool   `json:"joker,omitempty"`
+	FaceUp bool   `json:"faceup"`
+}
+
+func decodeCardJSON(cj cardJSON) (Card, error) {
+	if cj.Joker {
+		return Joker(), nil
+	}
+	rankRunes := []rune(strings.ToUpper(cj.Rank))
+	suitRunes := []rune(strings.ToUpper(cj.Suit))
+	if len(rankRunes) != 1 || len(suitRunes) != 1 {
+		return nil, ErrInvalidCard
+	}
+	r, ok := parseRank(rankRunes[0])
+	if !ok {
+		return nil, ErrInvalidCard
+	}
+	s, ok := parseSuit(suitRunes[0])
+	if !ok {
+		return nil, ErrInvalidCard
+	}
+	return NewCard(r, s), nil
+}
+
+// MarshalJSON implements json.Marshaler. Since a lone card has no direction,
+// it is always marshaled as face-up.
+func (c card) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cardJSON{Rank: string(c.rank.Short()), Suit: string(c.suit.Short()), FaceUp: true})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *card) UnmarshalJSON(data []byte) error {
+	var cj cardJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return err
+	}
+	if cj.Joker {
+		return ErrNotACard
+	}
+	decoded, err := decodeCardJSON(cj)
+	if err != nil {
+		return err
+	}
+	*c = decoded.(card)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (joker) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cardJSON{Joker: true, FaceUp: true})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *joker) UnmarshalJSON(data []byte) error {
+	var cj cardJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return err
+	}
+	if !cj.Joker {
+		return ErrNotACard
+	}
+	return nil
+}
+
+// UnmarshalCardJSON decodes a Card from its JSON form, as produced by
+// Card.MarshalJSON. It exists because Card is an interface, so there is no
+// concrete type for encoding/json helpers to unmarshal into directly.
+func UnmarshalCardJSON(data []byte) (Card, error) {
+	var cj cardJSON
+	if err := json.Unmarshal(data, &cj); err != nil {
+		return nil, err
+	}
+	return decodeCardJSON(cj)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the group as an array of
+// card objects in the same form as Card.MarshalJSON, with each object's
+// faceup field reflecting Group.Flipped.
+func (g *group) MarshalJSON() ([]byte, error) {
+	arr := make([]cardJSON, len(g.cards))
+	for i, c := range g.cards {
+		if c.Joker() {
+			arr[i] = cardJSON{Joker: true, FaceUp: g.flipd[i]}
+		} else {
+			arr[i] = cardJSON{Rank: string(c.Rank().Short()), Suit: string(c.Suit().Short()), FaceUp: g.flipd[i]}
+		}
+	}
+	return json.Marshal(arr)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *group) UnmarshalJSON(data []byte) error {
+	var arr []cardJSON
+	if err := json.Unmarshal(data, &arr); err != nil {
+		return err
+	}
+	cards := make([]Card, len(arr))
+	flipd := make([]bool, len(arr))
+	for i, cj := range arr {
+		c, err := decodeCardJSON(cj)
+		if err != nil {
+			return err
+		}
+		cards[i] = c
+		flipd[i] = cj.FaceUp
+	}
+	g.cards = cards
+	g.flipd = flipd
+	return nil
+}
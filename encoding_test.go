@@ -0,0 +1,136 @@
+package cards
+
+import "testing"
+
+func TestCardBinaryRoundTrip(t *testing.T) {
+	for _, c := range []Card{NewCard(Ace, Spades), NewCard(King, Clubs), Joker()} {
+		data, err := c.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v): unexpected error: %v", c, err)
+		}
+		got, err := UnmarshalCardBinary(data)
+		if err != nil {
+			t.Fatalf("UnmarshalCardBinary(%v): unexpected error: %v", c, err)
+		}
+		if got.Short() != c.Short() {
+			t.Errorf("binary round trip: got %v, want %v", got, c)
+		}
+	}
+}
+
+func TestCardTextRoundTrip(t *testing.T) {
+	for _, c := range []Card{NewCard(Ace, Spades), NewCard(Ten, Hearts), Joker()} {
+		data, err := c.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v): unexpected error: %v", c, err)
+		}
+		got, err := ParseCard(string(data))
+		if err != nil {
+			t.Fatalf("ParseCard(%q): unexpected error: %v", data, err)
+		}
+		if got.Short() != c.Short() {
+			t.Errorf("text round trip: got %v, want %v", got, c)
+		}
+	}
+}
+
+func TestCardJSONRoundTrip(t *testing.T) {
+	for _, c := range []Card{NewCard(Queen, Diamonds), Joker()} {
+		data, err := c.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%v): unexpected error: %v", c, err)
+		}
+		got, err := UnmarshalCardJSON(data)
+		if err != nil {
+			t.Fatalf("UnmarshalCardJSON(%q): unexpected error: %v", data, err)
+		}
+		if got.Short() != c.Short() {
+			t.Errorf("json round trip: got %v, want %v", got, c)
+		}
+	}
+}
+
+// TestGroupBinaryRoundTripPreservesJokerDirection is a regression test: a
+// face-down joker previously came back face-up after a binary round trip,
+// because the joker sentinel byte ignored its direction.
+func TestGroupBinaryRoundTripPreservesJokerDirection(t *testing.T) {
+	g := NewGroup(NewCard(Ace, Spades), NewCard(Ten, Hearts), Joker())
+	g.SetFlipped(0, true)
+	g.SetFlipped(1, false)
+	g.SetFlipped(2, false)
+
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error: %v", err)
+	}
+	got := NewGroup().(*group)
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: unexpected error: %v", err)
+	}
+	for i := 0; i < g.Len(); i++ {
+		if got.Flipped(i) != g.Flipped(i) {
+			t.Errorf("Flipped(%d) = %v, want %v", i, got.Flipped(i), g.Flipped(i))
+		}
+		if got.Card(i).Short() != g.Card(i).Short() {
+			t.Errorf("Card(%d) = %v, want %v", i, got.Card(i), g.Card(i))
+		}
+	}
+}
+
+func TestGroupBinaryRoundTrip(t *testing.T) {
+	g := NewStandardDeck()
+	g.FlipEach(true)
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error: %v", err)
+	}
+	got := NewGroup().(*group)
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: unexpected error: %v", err)
+	}
+	if got.String() != g.String() {
+		t.Errorf("binary round trip: got %s, want %s", got.String(), g.String())
+	}
+}
+
+func TestGroupTextRoundTrip(t *testing.T) {
+	g := NewStandardDeck().Draw(5)
+	g.SetFlipped(0, true)
+	data, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: unexpected error: %v", err)
+	}
+	got := NewGroup().(*group)
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText: unexpected error: %v", err)
+	}
+	if got.String() != g.String() {
+		t.Errorf("text round trip: got %s, want %s", got.String(), g.String())
+	}
+}
+
+func TestGroupJSONRoundTrip(t *testing.T) {
+	g := NewStandardDeck().Draw(5)
+	g.SetFlipped(0, true)
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: unexpected error: %v", err)
+	}
+	got := NewGroup().(*group)
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON: unexpected error: %v", err)
+	}
+	if got.String() != g.String() {
+		t.Errorf("json round trip: got %s, want %s", got.String(), g.String())
+	}
+}
+
+// TestEncodeCardByteRejectsJokerCollision is a regression test: a face-up
+// card with a custom suit/rank (see RegisterSuit/RegisterRank) reaching suit
+// 7, rank 14 or 15 previously encoded to the same byte as the joker sentinel.
+func TestEncodeCardByteRejectsJokerCollision(t *testing.T) {
+	c := NewCard(Rank(15), Suit(7))
+	if _, err := encodeCardByte(c, true); err == nil {
+		t.Errorf("encodeCardByte: expected error for a card colliding with the joker sentinel, got nil")
+	}
+}
@@ -1,6 +1,8 @@
 package cards
 
 import (
+	"encoding"
+	"encoding/json"
 	"sort"
 	"strings"
 )
@@ -63,8 +65,19 @@ type Group interface {
 	// InsertAt adds cards to position i in the group.
 	InsertAt(i int, g Group)
 
-	// Returns the group as a string.
+	// Returns the group as a string, using an uppercase short-string code
+	// for each face-up card and a lowercase code for each face-down card.
 	String() string
+
+	// Marshaling follows String's form for text, a length-prefixed packed
+	// byte per card for binary (see Card.MarshalBinary), and a JSON array
+	// of Card-shaped objects.
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	json.Marshaler
+	json.Unmarshaler
 }
 
 type group struct {
@@ -101,10 +114,19 @@ func (g *group) index(i *int) {
 	}
 }
 
+// String returns the group as a bracketed list of each card's short-string
+// notation. A card's code is uppercase if it is face-up, and lowercase if it
+// is face-down, so the result can be parsed back with ParseGroup without
+// losing FlippedArray information. A code with no cased letter, which only
+// custom registered ranks and suits can produce, reads back as face-up.
 func (g *group) String() string {
 	s := make([]string, len(g.cards))
 	for i := 0; i < len(g.cards); i++ {
-		s[i] = g.cards[i].Short()
+		sh := g.cards[i].Short()
+		if !g.flipd[i] {
+			sh = strings.ToLower(sh)
+		}
+		s[i] = sh
 	}
 	return "[ " + strings.Join(s, " ") + " ]"
 }
@@ -0,0 +1,313 @@
+// Package hand evaluates poker hands made up of cards from the cards
+// package.
+package hand
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/Anaminus/cards"
+)
+
+// ErrInvalidHand is returned by Evaluate when the given group does not
+// contain between 5 and 7 cards.
+var ErrInvalidHand = errors.New("hand: group must contain 5 to 7 cards")
+
+// Category is the classification of a poker hand, ordered from weakest to
+// strongest.
+type Category int
+
+const (
+	HighCard Category = iota
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	RoyalFlush
+)
+
+func (c Category) String() string {
+	switch c {
+	case HighCard:
+		return "High Card"
+	case OnePair:
+		return "One Pair"
+	case TwoPair:
+		return "Two Pair"
+	case ThreeOfAKind:
+		return "Three of a Kind"
+	case Straight:
+		return "Straight"
+	case Flush:
+		return "Flush"
+	case FullHouse:
+		return "Full House"
+	case FourOfAKind:
+		return "Four of a Kind"
+	case StraightFlush:
+		return "Straight Flush"
+	case RoyalFlush:
+		return "Royal Flush"
+	}
+	return "Unknown"
+}
+
+// Result is the outcome of evaluating a hand. Tiebreakers holds the ranks
+// relevant to the category, sorted by count (descending) then rank
+// (descending, ace-high), so that comparing two Results of the same Category
+// element-by-element resolves ties correctly. Cards holds the 5 cards (from
+// the original group, jokers included) that the Result was derived from.
+type Result struct {
+	Category    Category
+	Tiebreakers []cards.Rank
+	Cards       []cards.Card
+}
+
+// Compare returns a negative number if a is a weaker hand than b, a positive
+// number if a is stronger than b, and 0 if they are equal.
+func Compare(a, b Result) int {
+	if a.Category != b.Category {
+		if a.Category < b.Category {
+			return -1
+		}
+		return 1
+	}
+	return compareTiebreakers(a.Tiebreakers, b.Tiebreakers)
+}
+
+// Evaluate returns the best Result obtainable from a group of 5 to 7 cards.
+// For 6 or 7 cards, every 5-card subset is considered and the best is
+// returned. Jokers are treated as wild, substituting for whichever rank and
+// suit completes the best possible hand.
+func Evaluate(g cards.Group) (Result, error) {
+	cs := g.Cards()
+	if len(cs) < 5 || len(cs) > 7 {
+		return Result{}, ErrInvalidHand
+	}
+	if len(cs) == 5 {
+		return evaluateFive(cs), nil
+	}
+
+	best := Result{Category: -1}
+	for _, idx := range combinations(len(cs), 5) {
+		sub := make([]cards.Card, 5)
+		for i, j := range idx {
+			sub[i] = cs[j]
+		}
+		r := evaluateFive(sub)
+		if best.Category == -1 || Compare(r, best) > 0 {
+			best = r
+		}
+	}
+	return best, nil
+}
+
+func evaluateFive(orig []cards.Card) Result {
+	cat, tie := classifyWithWild(orig)
+	return Result{
+		Category:    cat,
+		Tiebreakers: tie,
+		Cards:       append([]cards.Card{}, orig...),
+	}
+}
+
+// rankValue returns the comparison weight of a rank, treating the ace as
+// high. This differs from the ace's underlying Rank value (which sorts
+// lowest, per cards.Rank's ordering), and is overridden for the A-2-3-4-5
+// wheel straight, whose high card is reported as the Five.
+func rankValue(r cards.Rank) int {
+	if r == cards.Ace {
+		return 14
+	}
+	return int(r)
+}
+
+func compareTiebreakers(a, b []cards.Rank) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		av, bv := rankValue(a[i]), rankValue(b[i])
+		if av != bv {
+			if av < bv {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+type cardKey struct {
+	rank cards.Rank
+	suit cards.Suit
+}
+
+var allRanks = []cards.Rank{
+	cards.Ace, cards.Two, cards.Three, cards.Four, cards.Five,
+	cards.Six, cards.Seven, cards.Eight, cards.Nine, cards.Ten,
+	cards.Jack, cards.Queen, cards.King,
+}
+
+var allSuits = []cards.Suit{cards.Spades, cards.Hearts, cards.Diamonds, cards.Clubs}
+
+// classifyWithWild classifies a 5-card hand, substituting each joker in orig
+// with whichever non-duplicate rank/suit yields the strongest Category and
+// Tiebreakers.
+func classifyWithWild(orig []cards.Card) (Category, []cards.Rank) {
+	fixed := make([]cards.Card, 0, len(orig))
+	wild := 0
+	for _, c := range orig {
+		if c.Joker() {
+			wild++
+		} else {
+			fixed = append(fixed, c)
+		}
+	}
+	if wild == 0 {
+		return classify(fixed)
+	}
+
+	used := make(map[cardKey]bool, len(fixed))
+	for _, c := range fixed {
+		used[cardKey{c.Rank(), c.Suit()}] = true
+	}
+
+	bestCat := Category(-1)
+	var bestTie []cards.Rank
+
+	var rec func(chosen []cards.Card, remaining int)
+	rec = func(chosen []cards.Card, remaining int) {
+		if remaining == 0 {
+			cat, tie := classify(append(append([]cards.Card{}, fixed...), chosen...))
+			if bestCat == -1 || cat > bestCat || (cat == bestCat && compareTiebreakers(tie, bestTie) > 0) {
+				bestCat = cat
+				bestTie = tie
+			}
+			return
+		}
+		for _, s := range allSuits {
+			for _, r := range allRanks {
+				k := cardKey{r, s}
+				if used[k] {
+					continue
+				}
+				used[k] = true
+				rec(append(chosen, cards.NewCard(r, s)), remaining-1)
+				delete(used, k)
+			}
+		}
+	}
+	rec(nil, wild)
+	return bestCat, bestTie
+}
+
+// classify classifies exactly 5 non-joker cards.
+func classify(cs []cards.Card) (Category, []cards.Rank) {
+	counts := map[cards.Rank]int{}
+	suits := map[cards.Suit]bool{}
+	for _, c := range cs {
+		counts[c.Rank()]++
+		suits[c.Suit()] = true
+	}
+	isFlush := len(suits) == 1
+	isStraight, straightHigh := detectStraight(counts)
+
+	type rankCount struct {
+		rank  cards.Rank
+		count int
+	}
+	list := make([]rankCount, 0, len(counts))
+	for r, n := range counts {
+		list = append(list, rankCount{r, n})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].count != list[j].count {
+			return list[i].count > list[j].count
+		}
+		return rankValue(list[i].rank) > rankValue(list[j].rank)
+	})
+	tiebreak := make([]cards.Rank, len(list))
+	for i, e := range list {
+		tiebreak[i] = e.rank
+	}
+
+	switch {
+	case isStraight && isFlush:
+		if straightHigh == cards.Ace {
+			return RoyalFlush, []cards.Rank{cards.Ace}
+		}
+		return StraightFlush, []cards.Rank{straightHigh}
+	case list[0].count == 4:
+		return FourOfAKind, tiebreak
+	case list[0].count == 3 && len(list) > 1 && list[1].count == 2:
+		return FullHouse, tiebreak
+	case isFlush:
+		return Flush, tiebreak
+	case isStraight:
+		return Straight, []cards.Rank{straightHigh}
+	case list[0].count == 3:
+		return ThreeOfAKind, tiebreak
+	case list[0].count == 2 && len(list) > 1 && list[1].count == 2:
+		return TwoPair, tiebreak
+	case list[0].count == 2:
+		return OnePair, tiebreak
+	default:
+		return HighCard, tiebreak
+	}
+}
+
+// detectStraight reports whether the given rank counts (exactly 5 cards)
+// form a straight, and if so, its high card. The A-2-3-4-5 wheel is handled
+// as a special case, reporting Five as the high card.
+func detectStraight(counts map[cards.Rank]int) (ok bool, high cards.Rank) {
+	if len(counts) != 5 {
+		return false, 0
+	}
+	if counts[cards.Ace] > 0 && counts[cards.Two] > 0 && counts[cards.Three] > 0 &&
+		counts[cards.Four] > 0 && counts[cards.Five] > 0 {
+		return true, cards.Five
+	}
+
+	vals := make([]int, 0, 5)
+	for r := range counts {
+		vals = append(vals, rankValue(r))
+	}
+	sort.Ints(vals)
+	for i := 1; i < len(vals); i++ {
+		if vals[i] != vals[i-1]+1 {
+			return false, 0
+		}
+	}
+	top := vals[len(vals)-1]
+	if top == 14 {
+		return true, cards.Ace
+	}
+	return true, cards.Rank(top)
+}
+
+// combinations returns the indices of every k-combination of [0,n).
+func combinations(n, k int) [][]int {
+	var result [][]int
+	idx := make([]int, k)
+	var rec func(start, depth int)
+	rec = func(start, depth int) {
+		if depth == k {
+			c := make([]int, k)
+			copy(c, idx)
+			result = append(result, c)
+			return
+		}
+		for i := start; i < n; i++ {
+			idx[depth] = i
+			rec(i+1, depth+1)
+		}
+	}
+	rec(0, 0)
+	return result
+}
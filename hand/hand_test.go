@@ -0,0 +1,100 @@
+package hand
+
+import (
+	"testing"
+
+	"github.com/Anaminus/cards"
+)
+
+func mustGroup(t *testing.T, s string) cards.Group {
+	t.Helper()
+	g, err := cards.ParseGroup(s)
+	if err != nil {
+		t.Fatalf("ParseGroup(%q): %v", s, err)
+	}
+	return g
+}
+
+func TestEvaluateCategories(t *testing.T) {
+	tests := []struct {
+		name string
+		hand string
+		want Category
+	}{
+		{"high card", "[ AS KH 9D 5C 2S ]", HighCard},
+		{"one pair", "[ AS AH 9D 5C 2S ]", OnePair},
+		{"two pair", "[ AS AH 9D 9C 2S ]", TwoPair},
+		{"three of a kind", "[ AS AH AD 5C 2S ]", ThreeOfAKind},
+		{"straight", "[ 5S 6H 7D 8C 9S ]", Straight},
+		{"wheel straight", "[ AS 2H 3D 4C 5S ]", Straight},
+		{"broadway straight", "[ TS JH QD KC AS ]", Straight},
+		{"flush", "[ 2S 5S 7S 9S KS ]", Flush},
+		{"full house", "[ AS AH AD 5C 5S ]", FullHouse},
+		{"four of a kind", "[ AS AH AD AC 5S ]", FourOfAKind},
+		{"straight flush", "[ 5S 6S 7S 8S 9S ]", StraightFlush},
+		{"royal flush", "[ TS JS QS KS AS ]", RoyalFlush},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Evaluate(mustGroup(t, tt.hand))
+			if err != nil {
+				t.Fatalf("Evaluate(%q): unexpected error: %v", tt.hand, err)
+			}
+			if r.Category != tt.want {
+				t.Errorf("Evaluate(%q).Category = %v, want %v", tt.hand, r.Category, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateSevenCardPicksBest(t *testing.T) {
+	r, err := Evaluate(mustGroup(t, "[ AS AH AD AC 5S 2H 3D ]"))
+	if err != nil {
+		t.Fatalf("Evaluate: unexpected error: %v", err)
+	}
+	if r.Category != FourOfAKind {
+		t.Errorf("Evaluate 7-card hand: Category = %v, want %v", r.Category, FourOfAKind)
+	}
+}
+
+func TestEvaluateJokerWild(t *testing.T) {
+	r, err := Evaluate(mustGroup(t, "[ AS AH AD JO 5S ]"))
+	if err != nil {
+		t.Fatalf("Evaluate: unexpected error: %v", err)
+	}
+	if r.Category != FourOfAKind {
+		t.Errorf("Evaluate with joker: Category = %v, want %v", r.Category, FourOfAKind)
+	}
+}
+
+func TestEvaluateInvalidSize(t *testing.T) {
+	if _, err := Evaluate(mustGroup(t, "[ AS AH AD AC ]")); err != ErrInvalidHand {
+		t.Errorf("Evaluate with 4 cards: err = %v, want %v", err, ErrInvalidHand)
+	}
+}
+
+func TestCompareOrdering(t *testing.T) {
+	pair, err := Evaluate(mustGroup(t, "[ AS AH 9D 5C 2S ]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	flush, err := Evaluate(mustGroup(t, "[ 2S 5S 7S 9S KS ]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Compare(pair, flush) >= 0 {
+		t.Errorf("Compare(pair, flush) = %d, want < 0", Compare(pair, flush))
+	}
+
+	broadway, err := Evaluate(mustGroup(t, "[ TS JH QD KC AS ]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kingHigh, err := Evaluate(mustGroup(t, "[ 9S TH JD QC KS ]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Compare(broadway, kingHigh) <= 0 {
+		t.Errorf("Compare(broadway, kingHigh) = %d, want > 0", Compare(broadway, kingHigh))
+	}
+}
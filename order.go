@@ -1,23 +1,130 @@
-package cards
-
-import (
-	crand "crypto/rand"
-	"math/big"
-)
-
-// PerfectShuffle shuffles a group of cards with perfect randomness by using
-// the Fisher-Yates shuffle.
-func PerfectShuffle(g Group) {
-	for i := g.Len() - 1; i > 0; i-- {
-		j, _ := crand.Int(crand.Reader, big.NewInt(int64(i)+1))
-		g.Swap(i, int(j.Int64()))
-	}
-}
-
-// Reverse reverses the cards in a group.
-func Reverse(g Group) {
-	for i := g.Len()/2 - 1; i >= 0; i-- {
-		j := g.Len() - 1 - i
-		g.Swap(i, j)
-	}
-}
+package cards
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"time"
+)
+
+// PerfectShuffle shuffles a group of cards with perfect randomness by using
+// the Fisher-Yates shuffle.
+func PerfectShuffle(g Group) {
+	for i := g.Len() - 1; i > 0; i-- {
+		j, _ := crand.Int(crand.Reader, big.NewInt(int64(i)+1))
+		g.Swap(i, int(j.Int64()))
+	}
+}
+
+// Reverse reverses the cards in a group.
+func Reverse(g Group) {
+	for i := g.Len()/2 - 1; i >= 0; i-- {
+		j := g.Len() - 1 - i
+		g.Swap(i, j)
+	}
+}
+
+// Shuffler generates the random numbers used to drive a shuffling algorithm.
+// Intn must return a non-negative random number in the half-open interval
+// [0,n). *math/rand.Rand satisfies this interface, so games that need
+// reproducible or otherwise custom randomness can inject their own source.
+type Shuffler interface {
+	Intn(n int) int
+}
+
+// Shuffle performs a Fisher-Yates shuffle of g, drawing randomness from s.
+func Shuffle(g Group, s Shuffler) {
+	for i := g.Len() - 1; i > 0; i-- {
+		j := s.Intn(i + 1)
+		g.Swap(i, j)
+	}
+}
+
+// SeededShuffle shuffles a group with a Fisher-Yates shuffle driven by
+// math/rand, seeded with seed. Unlike PerfectShuffle, this is reproducible,
+// making it suitable for tests and other scenarios that need a repeatable
+// deal.
+func SeededShuffle(g Group, seed int64) {
+	Shuffle(g, mrand.New(mrand.NewSource(seed)))
+}
+
+func timeShuffler() *mrand.Rand {
+	return mrand.New(mrand.NewSource(time.Now().UnixNano()))
+}
+
+// RiffleShuffle simulates n passes of a physical riffle shuffle, using the
+// Gilbert-Shannon-Reeds model: the deck is cut in two according to a
+// binomial split, then the two halves are interleaved one card at a time,
+// each draw favoring whichever half has more cards remaining.
+func RiffleShuffle(g Group, n int) {
+	s := timeShuffler()
+	for ; n > 0; n-- {
+		riffleOnce(g, s)
+	}
+}
+
+func riffleOnce(g Group, s Shuffler) {
+	total := g.Len()
+	if total < 2 {
+		return
+	}
+	a := g.Draw(binomialSplit(s, total))
+	b := g.Draw(g.Len())
+	for a.Len() > 0 || b.Len() > 0 {
+		from := b
+		switch {
+		case b.Len() == 0:
+			from = a
+		case a.Len() == 0:
+			from = b
+		case s.Intn(a.Len()+b.Len()) < a.Len():
+			from = a
+		}
+		g.Insert(from.DrawBottom(1))
+	}
+}
+
+// binomialSplit returns a cut point for n cards drawn from a Binomial(n,
+// 1/2) distribution, as used by the Gilbert-Shannon-Reeds model.
+func binomialSplit(s Shuffler, n int) int {
+	cut := 0
+	for i := 0; i < n; i++ {
+		if s.Intn(2) == 0 {
+			cut++
+		}
+	}
+	return cut
+}
+
+// OverhandShuffle simulates n passes of a physical overhand shuffle: small
+// packets of cards are repeatedly peeled from the top of the deck and
+// stacked on top of a new pile, which reverses the order of the packets.
+func OverhandShuffle(g Group, n int) {
+	s := timeShuffler()
+	for ; n > 0; n-- {
+		overhandOnce(g, s)
+	}
+}
+
+func overhandOnce(g Group, s Shuffler) {
+	rest := g.Draw(g.Len())
+	for rest.Len() > 0 {
+		size := 1 + s.Intn(rest.Len()/3+1)
+		if size > rest.Len() {
+			size = rest.Len()
+		}
+		g.Insert(rest.Draw(size))
+	}
+}
+
+// CutShuffle performs a single cut of the deck: a random number of cards are
+// taken from the bottom of the group and placed on top.
+func CutShuffle(g Group) {
+	s := timeShuffler()
+	n := g.Len()
+	if n < 2 {
+		return
+	}
+	cut := 1 + s.Intn(n-1)
+	g.Insert(g.DrawBottom(cut))
+}
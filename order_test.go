@@ -0,0 +1,82 @@
+package cards
+
+import "testing"
+
+// sameCards reports whether a and b contain the same cards, ignoring order.
+func sameCards(a, b []Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, c := range a {
+		counts[c.Short()]++
+	}
+	for _, c := range b {
+		counts[c.Short()]--
+	}
+	for _, n := range counts {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSeededShuffleDeterministic(t *testing.T) {
+	a := NewStandardDeck()
+	b := NewStandardDeck()
+	SeededShuffle(a, 42)
+	SeededShuffle(b, 42)
+	if a.String() != b.String() {
+		t.Errorf("SeededShuffle with the same seed produced different orders:\n%s\n%s", a.String(), b.String())
+	}
+	if !sameCards(a.Cards(), NewStandardDeck().Cards()) {
+		t.Errorf("SeededShuffle changed the set of cards in the deck")
+	}
+}
+
+func TestSeededShuffleDifferentSeeds(t *testing.T) {
+	a := NewStandardDeck()
+	b := NewStandardDeck()
+	SeededShuffle(a, 1)
+	SeededShuffle(b, 2)
+	if a.String() == b.String() {
+		t.Errorf("SeededShuffle with different seeds produced the same order")
+	}
+}
+
+func TestRiffleShufflePreservesCards(t *testing.T) {
+	deck := NewStandardDeck()
+	orig := NewStandardDeck().Cards()
+	RiffleShuffle(deck, 3)
+	if deck.Len() != 52 {
+		t.Fatalf("RiffleShuffle: Len() = %d, want 52", deck.Len())
+	}
+	if !sameCards(deck.Cards(), orig) {
+		t.Errorf("RiffleShuffle changed the set of cards in the deck")
+	}
+}
+
+func TestOverhandShufflePreservesCards(t *testing.T) {
+	deck := NewStandardDeck()
+	orig := NewStandardDeck().Cards()
+	OverhandShuffle(deck, 3)
+	if deck.Len() != 52 {
+		t.Fatalf("OverhandShuffle: Len() = %d, want 52", deck.Len())
+	}
+	if !sameCards(deck.Cards(), orig) {
+		t.Errorf("OverhandShuffle changed the set of cards in the deck")
+	}
+}
+
+func TestCutShufflePreservesCards(t *testing.T) {
+	deck := NewStandardDeck()
+	orig := NewStandardDeck().Cards()
+	CutShuffle(deck)
+	if deck.Len() != 52 {
+		t.Fatalf("CutShuffle: Len() = %d, want 52", deck.Len())
+	}
+	if !sameCards(deck.Cards(), orig) {
+		t.Errorf("CutShuffle changed the set of cards in the deck")
+	}
+}
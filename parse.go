@@ -0,0 +1,143 @@
+package cards
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCard is returned by ParseCard when the given string does not
+// describe a valid card.
+var ErrInvalidCard = errors.New("cards: invalid card")
+
+// ErrInvalidGroup is returned by ParseGroup when the given string does not
+// describe a valid group.
+var ErrInvalidGroup = errors.New("cards: invalid group")
+
+// ErrDuplicateCard is returned by ParseGroup when RejectDuplicates is set and
+// the same card appears more than once.
+var ErrDuplicateCard = errors.New("cards: duplicate card")
+
+// ParseOptions configures the behavior of ParseGroup.
+type ParseOptions struct {
+	// RejectDuplicates causes ParseGroup to return ErrDuplicateCard if a
+	// non-joker card appears more than once.
+	RejectDuplicates bool
+}
+
+// ParseCard parses a card from its short-string notation, as emitted by
+// Card.Short (e.g. "AS", "TH", "JO"). The parse is case-insensitive.
+func ParseCard(s string) (Card, error) {
+	c, _, err := parseToken(s)
+	return c, err
+}
+
+// MustParseCard is like ParseCard, but panics if s cannot be parsed.
+func MustParseCard(s string) Card {
+	c, err := ParseCard(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// ParseGroup parses a group from its bracketed short-string notation, as
+// emitted by Group.String (e.g. "[ AS TH 2C JO ]"). Each card follows the
+// convention used by Group.String: an uppercase code denotes a face-up card,
+// and a lowercase code denotes a face-down card, so the result of
+// FlippedArray round-trips through ParseGroup. A code with no cased letter,
+// which only custom registered ranks and suits can produce, is read as
+// face-up.
+//
+// An optional ParseOptions may be given to control parsing behavior.
+func ParseGroup(s string, opts ...ParseOptions) (Group, error) {
+	var opt ParseOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return nil, ErrInvalidGroup
+	}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+
+	var fields []string
+	if inner != "" {
+		fields = strings.Fields(inner)
+	}
+
+	cards := make([]Card, len(fields))
+	flipd := make([]bool, len(fields))
+	var seen map[string]bool
+	if opt.RejectDuplicates {
+		seen = make(map[string]bool, len(fields))
+	}
+	for i, f := range fields {
+		c, faceup, err := parseToken(f)
+		if err != nil {
+			return nil, err
+		}
+		if opt.RejectDuplicates && !c.Joker() {
+			key := c.Short()
+			if seen[key] {
+				return nil, ErrDuplicateCard
+			}
+			seen[key] = true
+		}
+		cards[i] = c
+		flipd[i] = faceup
+	}
+
+	return &group{cards: cards, flipd: flipd}, nil
+}
+
+// MustParseGroup is like ParseGroup, but panics if s cannot be parsed.
+func MustParseGroup(s string) Group {
+	g, err := ParseGroup(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// parseToken parses a single card token, returning the card along with
+// whether the token indicated a face-up (uppercase) or face-down (lowercase)
+// card.
+func parseToken(s string) (Card, bool, error) {
+	if s == "" {
+		return nil, false, ErrInvalidCard
+	}
+	upper := strings.ToUpper(s)
+	faceup := s == upper
+
+	if upper == "JO" {
+		return Joker(), faceup, nil
+	}
+	runes := []rune(upper)
+	if len(runes) != 2 {
+		return nil, false, ErrInvalidCard
+	}
+	rank, ok := parseRank(runes[0])
+	if !ok {
+		return nil, false, ErrInvalidCard
+	}
+	suit, ok := parseSuit(runes[1])
+	if !ok {
+		return nil, false, ErrInvalidCard
+	}
+	return NewCard(rank, suit), faceup, nil
+}
+
+// parseRank looks up the Rank whose Short rune is c, among the built-in
+// ranks as well as any registered with RegisterRank.
+func parseRank(c rune) (Rank, bool) {
+	r, ok := rankByShort[c]
+	return r, ok
+}
+
+// parseSuit looks up the Suit whose Short rune is c, among the built-in
+// suits as well as any registered with RegisterSuit.
+func parseSuit(c rune) (Suit, bool) {
+	s, ok := suitByShort[c]
+	return s, ok
+}
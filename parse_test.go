@@ -0,0 +1,122 @@
+package cards
+
+import "testing"
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		s     string
+		rank  Rank
+		suit  Suit
+		joker bool
+	}{
+		{"AS", Ace, Spades, false},
+		{"TH", Ten, Hearts, false},
+		{"2C", Two, Clubs, false},
+		{"KD", King, Diamonds, false},
+		{"JO", 0, 0, true},
+		{"jo", 0, 0, true},
+	}
+	for _, tt := range tests {
+		c, err := ParseCard(tt.s)
+		if err != nil {
+			t.Errorf("ParseCard(%q): unexpected error: %v", tt.s, err)
+			continue
+		}
+		if c.Joker() != tt.joker {
+			t.Errorf("ParseCard(%q).Joker() = %v, want %v", tt.s, c.Joker(), tt.joker)
+		}
+		if !tt.joker {
+			if c.Rank() != tt.rank || c.Suit() != tt.suit {
+				t.Errorf("ParseCard(%q) = %v %v, want %v %v", tt.s, c.Rank(), c.Suit(), tt.rank, tt.suit)
+			}
+		}
+	}
+}
+
+func TestParseCardRoundTrip(t *testing.T) {
+	deck := NewStandardDeck()
+	for _, c := range deck.Cards() {
+		s := c.Short()
+		got, err := ParseCard(s)
+		if err != nil {
+			t.Fatalf("ParseCard(%q): unexpected error: %v", s, err)
+		}
+		if got.Short() != s {
+			t.Errorf("ParseCard(%q).Short() = %q, want %q", s, got.Short(), s)
+		}
+	}
+}
+
+func TestParseCardInvalid(t *testing.T) {
+	for _, s := range []string{"", "Z", "ZZZ", "1S", "AZ"} {
+		if _, err := ParseCard(s); err == nil {
+			t.Errorf("ParseCard(%q): expected error, got nil", s)
+		}
+	}
+}
+
+func TestParseGroup(t *testing.T) {
+	g, err := ParseGroup("[ AS TH 2C JO ]")
+	if err != nil {
+		t.Fatalf("ParseGroup: unexpected error: %v", err)
+	}
+	if g.Len() != 4 {
+		t.Fatalf("ParseGroup: Len() = %d, want 4", g.Len())
+	}
+	if g.Card(0).Short() != "AS" || g.Card(3).Short() != "JO" {
+		t.Errorf("ParseGroup: unexpected cards: %v", g.Cards())
+	}
+}
+
+func TestParseGroupEmpty(t *testing.T) {
+	g, err := ParseGroup("[ ]")
+	if err != nil {
+		t.Fatalf("ParseGroup: unexpected error: %v", err)
+	}
+	if g.Len() != 0 {
+		t.Errorf("ParseGroup: Len() = %d, want 0", g.Len())
+	}
+}
+
+func TestParseGroupFlippedRoundTrip(t *testing.T) {
+	g := NewGroup(NewCard(Ace, Spades), NewCard(Ten, Hearts))
+	g.SetFlipped(0, true)
+	g.SetFlipped(1, false)
+
+	s := g.String()
+	got, err := ParseGroup(s)
+	if err != nil {
+		t.Fatalf("ParseGroup(%q): unexpected error: %v", s, err)
+	}
+	for i := 0; i < g.Len(); i++ {
+		if got.Flipped(i) != g.Flipped(i) {
+			t.Errorf("ParseGroup(%q).Flipped(%d) = %v, want %v", s, i, got.Flipped(i), g.Flipped(i))
+		}
+	}
+}
+
+func TestParseGroupInvalid(t *testing.T) {
+	for _, s := range []string{"", "AS TH", "[ AS", "[ ZZ ]"} {
+		if _, err := ParseGroup(s); err == nil {
+			t.Errorf("ParseGroup(%q): expected error, got nil", s)
+		}
+	}
+}
+
+func TestParseGroupRejectDuplicates(t *testing.T) {
+	if _, err := ParseGroup("[ AS AS ]", ParseOptions{RejectDuplicates: true}); err != ErrDuplicateCard {
+		t.Errorf("ParseGroup with duplicate: err = %v, want %v", err, ErrDuplicateCard)
+	}
+	if _, err := ParseGroup("[ AS AS ]"); err != nil {
+		t.Errorf("ParseGroup without RejectDuplicates: unexpected error: %v", err)
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseCard: expected panic on invalid input")
+		}
+	}()
+	MustParseCard("ZZ")
+}